@@ -0,0 +1,318 @@
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// GetTxCmd returns the transaction commands for IBC clients
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	ibcClientTxCmd := &cobra.Command{
+		Use:                        "client",
+		Short:                      "IBC client transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ibcClientTxCmd.AddCommand(flags.PostCommands(
+		GetCmdCreateClient(cdc),
+		GetCmdUpdateClient(cdc),
+		GetCmdSubmitMisbehaviour(cdc),
+		GetCmdUpgradeClient(cdc),
+	)...)
+
+	return ibcClientTxCmd
+}
+
+// GetCmdCreateClient defines the command to create a new IBC light client.
+// Unlike a client-specific command, the client and consensus state are read
+// from JSON files and decoded into the `exported.ClientState` and
+// `exported.ConsensusState` interfaces via `UnmarshalInterfaceJSON`, so the
+// same command works for Tendermint, solo machine or any future light
+// client registered on the interface registry.
+func GetCmdCreateClient(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [client-id] [path/to/client_state.json] [path/to/consensus_state.json]",
+		Short: "create new client",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`create new client with a client id and the client and consensus state
+
+Example:
+$ %s tx ibc client create [client-id] [path/to/client_state.json] [path/to/consensus_state.json] --from node0 --home ../node0/<app>cli --chain-id $CID
+		`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(authtxb.GetTxEncoder(cdc))
+
+			clientID := args[0]
+
+			var clientState exported.ClientState
+			clientContentOrFileName := args[1]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(clientContentOrFileName), &clientState); err != nil {
+				contents, ferr := ioutil.ReadFile(clientContentOrFileName)
+				if ferr != nil {
+					return fmt.Errorf("error opening client state file: %w", ferr)
+				}
+				if err := cdc.UnmarshalInterfaceJSON(contents, &clientState); err != nil {
+					return fmt.Errorf("error unmarshalling client state file: %w", err)
+				}
+			}
+
+			var consensusState exported.ConsensusState
+			consStateContentOrFileName := args[2]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(consStateContentOrFileName), &consensusState); err != nil {
+				contents, ferr := ioutil.ReadFile(consStateContentOrFileName)
+				if ferr != nil {
+					return fmt.Errorf("error opening consensus state file: %w", ferr)
+				}
+				if err := cdc.UnmarshalInterfaceJSON(contents, &consensusState); err != nil {
+					return fmt.Errorf("error unmarshalling consensus state file: %w", err)
+				}
+			}
+
+			msg, err := types.NewMsgCreateClient(clientID, clientState, consensusState, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authtxb.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdUpdateClient defines the command to update an existing client with
+// a new header
+func GetCmdUpdateClient(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [client-id] [path/to/header.json]",
+		Short: "update existing client with a header",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`update existing client with a header
+
+Example:
+$ %s tx ibc client update [client-id] [path/to/header.json] --from node0 --home ../node0/<app>cli --chain-id $CID
+		`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(authtxb.GetTxEncoder(cdc))
+
+			clientID := args[0]
+
+			var header exported.Header
+			headerContentOrFileName := args[1]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(headerContentOrFileName), &header); err != nil {
+				contents, ferr := ioutil.ReadFile(headerContentOrFileName)
+				if ferr != nil {
+					return fmt.Errorf("error opening header file: %w", ferr)
+				}
+				if err := cdc.UnmarshalInterfaceJSON(contents, &header); err != nil {
+					return fmt.Errorf("error unmarshalling header file: %w", err)
+				}
+			}
+
+			msg, err := types.NewMsgUpdateClient(clientID, header, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authtxb.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdSubmitMisbehaviour defines the command to submit misbehaviour
+// evidence for a client, freezing it on success.
+func GetCmdSubmitMisbehaviour(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "misbehaviour [client-id] [path/to/misbehaviour.json]",
+		Short: "submit a client misbehaviour",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`submit a client misbehaviour to freeze the light client
+
+Example:
+$ %s tx ibc client misbehaviour [client-id] [path/to/misbehaviour.json] --from node0 --home ../node0/<app>cli --chain-id $CID
+		`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(authtxb.GetTxEncoder(cdc))
+
+			clientID := args[0]
+
+			var misbehaviour exported.Misbehaviour
+			misbehaviourContentOrFileName := args[1]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(misbehaviourContentOrFileName), &misbehaviour); err != nil {
+				contents, ferr := ioutil.ReadFile(misbehaviourContentOrFileName)
+				if ferr != nil {
+					return fmt.Errorf("error opening misbehaviour file: %w", ferr)
+				}
+				if err := cdc.UnmarshalInterfaceJSON(contents, &misbehaviour); err != nil {
+					return fmt.Errorf("error unmarshalling misbehaviour file: %w", err)
+				}
+			}
+
+			msg, err := types.NewMsgSubmitMisbehaviour(clientID, misbehaviour, cliCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authtxb.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdUpgradeClient defines the command to upgrade an IBC client to a new
+// client state, used when a counterparty chain plans a coordinated upgrade.
+// The two Merkle proofs may be omitted, in which case they are queried
+// automatically against the counterparty's upgrade store via
+// `utils.QueryUpgradeProof`, at the last height before upgrade-height (the
+// height at which the counterparty committed the upgraded client and
+// consensus state to its upgrade store). If supplied manually, the proofs
+// must be base64-encoded, matching the JSON encoding of proof bytes returned
+// by the REST queries in client/rest.
+func GetCmdUpgradeClient(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "upgrade [client-id] [path/to/new_client_state.json] [path/to/new_consensus_state.json] [upgrade-height] " +
+			"[base64-proof-upgrade-client] [base64-proof-upgrade-consensus]",
+		Short: "upgrade an existing client with a new client and consensus state",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`upgrade an existing client with a new client and consensus state
+
+upgrade-height is the height of the counterparty's upgrade as announced in
+its upgrade plan; the proofs are taken at upgrade-height - 1, the last
+height before the counterparty's upgrade store entries were overwritten. If
+the Merkle proofs are omitted they are queried automatically at that height.
+
+Example:
+$ %s tx ibc client upgrade [client-id] [path/to/new_client_state.json] [path/to/new_consensus_state.json] [upgrade-height] --from node0 --home ../node0/<app>cli --chain-id $CID
+		`, version.ClientName),
+		),
+		Args: cobra.RangeArgs(4, 6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(authtxb.GetTxEncoder(cdc))
+
+			clientID := args[0]
+
+			// confirm the client actually exists on chain before spending a
+			// query round-trip (and the operator's time) on proofs for it
+			if _, err := utils.QueryClientState(cliCtx, clientID, false); err != nil {
+				return fmt.Errorf("could not find existing client with ID %s: %w", clientID, err)
+			}
+
+			var clientState exported.ClientState
+			clientContentOrFileName := args[1]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(clientContentOrFileName), &clientState); err != nil {
+				contents, ferr := ioutil.ReadFile(clientContentOrFileName)
+				if ferr != nil {
+					return fmt.Errorf("error opening new client state file: %w", ferr)
+				}
+				if err := cdc.UnmarshalInterfaceJSON(contents, &clientState); err != nil {
+					return fmt.Errorf("error unmarshalling new client state file: %w", err)
+				}
+			}
+
+			var consensusState exported.ConsensusState
+			consStateContentOrFileName := args[2]
+			if err := cdc.UnmarshalInterfaceJSON([]byte(consStateContentOrFileName), &consensusState); err != nil {
+				contents, ferr := ioutil.ReadFile(consStateContentOrFileName)
+				if ferr != nil {
+					return fmt.Errorf("error opening new consensus state file: %w", ferr)
+				}
+				if err := cdc.UnmarshalInterfaceJSON(contents, &consensusState); err != nil {
+					return fmt.Errorf("error unmarshalling new consensus state file: %w", err)
+				}
+			}
+
+			upgradeHeight, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected integer upgrade height, got: %v", args[3])
+			}
+			if upgradeHeight == 0 {
+				return fmt.Errorf("upgrade height must be positive")
+			}
+
+			// the counterparty writes the upgraded client and consensus
+			// state into its upgrade store during the last block it commits
+			// before the upgrade, i.e. at upgradeHeight - 1
+			queryHeight := upgradeHeight - 1
+
+			var proofUpgradeClient []byte
+			if len(args) > 4 {
+				proof, err := base64.StdEncoding.DecodeString(args[4])
+				if err != nil {
+					return fmt.Errorf("error decoding proof-upgrade-client as base64: %w", err)
+				}
+				proofUpgradeClient = proof
+			} else {
+				proof, _, err := utils.QueryUpgradeProof(cliCtx, []byte(types.KeyUpgradeClient), queryHeight)
+				if err != nil {
+					return fmt.Errorf("error querying proof of client upgrade: %w", err)
+				}
+				proofUpgradeClient = proof
+			}
+
+			var proofUpgradeConsState []byte
+			if len(args) > 5 {
+				proof, err := base64.StdEncoding.DecodeString(args[5])
+				if err != nil {
+					return fmt.Errorf("error decoding proof-upgrade-consensus as base64: %w", err)
+				}
+				proofUpgradeConsState = proof
+			} else {
+				proof, _, err := utils.QueryUpgradeProof(cliCtx, []byte(types.KeyUpgradeConsState), queryHeight)
+				if err != nil {
+					return fmt.Errorf("error querying proof of consensus state upgrade: %w", err)
+				}
+				proofUpgradeConsState = proof
+			}
+
+			msg, err := types.NewMsgUpgradeClient(
+				clientID, clientState, consensusState, proofUpgradeClient, proofUpgradeConsState, cliCtx.GetFromAddress(),
+			)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authtxb.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,160 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	commitment "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment"
+)
+
+// RegisterRoutes registers the IBC client REST handlers on the given
+// router, mirroring the cobra CLI commands in client/cli/query.go so that
+// relayer processes that cannot exec the CLI (TypeScript, Rust, ...) can
+// query client state over HTTP.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/ibc/clients/{client-id}/client-state", queryClientStateHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/ibc/clients/{client-id}/consensus-state", queryConsensusStateHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/ibc/clients/{client-id}/roots/{height}", queryRootHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/ibc/clients/header", queryHeaderHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/ibc/clients/node-state", queryNodeConsensusStateHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/ibc/clients/path", queryPathHandlerFn(cliCtx)).Methods("GET")
+}
+
+// queryClientStateHandlerFn mirrors GetCmdQueryClientState
+func queryClientStateHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientID := mux.Vars(r)["client-id"]
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		prove := parseProve(r)
+
+		clientStateRes, err := utils.QueryClientState(cliCtx, clientID, prove)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, clientStateRes)
+	}
+}
+
+// queryConsensusStateHandlerFn mirrors GetCmdQueryConsensusState
+func queryConsensusStateHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientID := mux.Vars(r)["client-id"]
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		prove := parseProve(r)
+
+		csRes, err := utils.QueryConsensusStateProof(cliCtx, clientID, prove)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, csRes)
+	}
+}
+
+// queryRootHandlerFn mirrors GetCmdQueryRoot
+func queryRootHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		clientID := vars["client-id"]
+
+		height, err := strconv.ParseUint(vars["height"], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("expected integer height, got: %v", vars["height"]))
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		prove := parseProve(r)
+
+		rootRes, err := utils.QueryCommitmentRoot(cliCtx, clientID, height, prove)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, rootRes)
+	}
+}
+
+// queryHeaderHandlerFn mirrors GetCmdQueryHeader
+func queryHeaderHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		header, _, err := utils.QueryTendermintHeader(cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, header)
+	}
+}
+
+// queryNodeConsensusStateHandlerFn mirrors GetCmdNodeConsensusState
+func queryNodeConsensusStateHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		state, _, err := utils.QueryNodeConsensusState(cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx, state)
+	}
+}
+
+// queryPathHandlerFn mirrors GetCmdQueryPath
+func queryPathHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		path := commitment.NewPrefix([]byte("ibc"))
+		rest.PostProcessResponseBare(w, cliCtx, path)
+	}
+}
+
+// parseProve parses the `?prove=true|false` query parameter, defaulting to
+// true to match the CLI's --prove flag default.
+func parseProve(r *http.Request) bool {
+	proveStr := r.URL.Query().Get("prove")
+	if proveStr == "" {
+		return true
+	}
+
+	prove, err := strconv.ParseBool(proveStr)
+	if err != nil {
+		return true
+	}
+
+	return prove
+}
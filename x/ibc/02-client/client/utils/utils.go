@@ -0,0 +1,197 @@
+package utils
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ics23 "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	tendermint "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/tendermint/types"
+)
+
+// queryProof queries the IBC store for the value under key, along with a
+// Merkle proof of its (non-)inclusion when prove is true. It mirrors
+// QueryUpgradeProof's shape but targets the IBC store rather than the
+// upgrade store.
+func queryProof(cliCtx context.CLIContext, key []byte, prove bool) (value, proofBz []byte, height int64, err error) {
+	req := abci.RequestQuery{
+		Path:  "store/ibc/key",
+		Data:  key,
+		Prove: prove,
+	}
+
+	res, err := cliCtx.QueryABCI(req)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	if prove {
+		merkleProof := ics23.MerkleProof{Proof: res.Proof}
+		proofBz, err = cliCtx.Codec.MarshalBinaryBare(merkleProof)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	}
+
+	return res.Value, proofBz, res.Height, nil
+}
+
+// QueryClientState returns the client state registered under clientID, along
+// with a proof of its storage at the queried height.
+func QueryClientState(cliCtx context.CLIContext, clientID string, prove bool) (types.ClientStateResponse, error) {
+	key := host.FullClientStateKey(clientID)
+
+	value, proofBz, height, err := queryProof(cliCtx, key, prove)
+	if err != nil {
+		return types.ClientStateResponse{}, err
+	}
+	if len(value) == 0 {
+		return types.ClientStateResponse{}, sdkerrors.Wrapf(types.ErrClientNotFound, "client ID: %s", clientID)
+	}
+
+	var clientState exported.ClientState
+	if err := cliCtx.Codec.UnmarshalInterface(value, &clientState); err != nil {
+		return types.ClientStateResponse{}, err
+	}
+
+	return types.NewClientStateResponse(clientState, proofBz, string(key), height), nil
+}
+
+// QueryConsensusStateProof returns the latest consensus state of the client
+// registered under clientID, along with a proof of its storage at the
+// queried height.
+func QueryConsensusStateProof(cliCtx context.CLIContext, clientID string, prove bool) (types.ConsensusStateResponse, error) {
+	clientStateRes, err := QueryClientState(cliCtx, clientID, false)
+	if err != nil {
+		return types.ConsensusStateResponse{}, err
+	}
+
+	height := clientStateRes.ClientState.GetLatestHeight()
+	key := host.FullConsensusStateKey(clientID, height)
+
+	value, proofBz, proofHeight, err := queryProof(cliCtx, key, prove)
+	if err != nil {
+		return types.ConsensusStateResponse{}, err
+	}
+	if len(value) == 0 {
+		return types.ConsensusStateResponse{}, sdkerrors.Wrapf(
+			types.ErrConsensusStateNotFound, "client ID: %s, height: %d", clientID, height,
+		)
+	}
+
+	var consensusState exported.ConsensusState
+	if err := cliCtx.Codec.UnmarshalInterface(value, &consensusState); err != nil {
+		return types.ConsensusStateResponse{}, err
+	}
+
+	return types.NewConsensusStateResponse(consensusState, proofBz, string(key), proofHeight), nil
+}
+
+// QueryCommitmentRoot returns the commitment root of the consensus state of
+// the client registered under clientID at the given height, along with a
+// proof of the consensus state's storage at the queried height.
+func QueryCommitmentRoot(cliCtx context.CLIContext, clientID string, height uint64, prove bool) (types.RootResponse, error) {
+	key := host.FullConsensusStateKey(clientID, height)
+
+	value, proofBz, proofHeight, err := queryProof(cliCtx, key, prove)
+	if err != nil {
+		return types.RootResponse{}, err
+	}
+	if len(value) == 0 {
+		return types.RootResponse{}, sdkerrors.Wrapf(
+			types.ErrConsensusStateNotFound, "client ID: %s, height: %d", clientID, height,
+		)
+	}
+
+	var consensusState exported.ConsensusState
+	if err := cliCtx.Codec.UnmarshalInterface(value, &consensusState); err != nil {
+		return types.RootResponse{}, err
+	}
+
+	return types.NewRootResponse(consensusState.GetRoot(), proofBz, string(key), proofHeight), nil
+}
+
+// QueryTendermintHeader returns the Tendermint header needed to create or
+// update a Tendermint client, built from the signed header and validator set
+// of the chain's latest block.
+func QueryTendermintHeader(cliCtx context.CLIContext) (tendermint.Header, int64, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return tendermint.Header{}, 0, err
+	}
+
+	info, err := node.ABCIInfo()
+	if err != nil {
+		return tendermint.Header{}, 0, err
+	}
+
+	height := info.Response.LastBlockHeight
+
+	commit, err := node.Commit(&height)
+	if err != nil {
+		return tendermint.Header{}, 0, err
+	}
+
+	validators, err := node.Validators(&height, 1, 100)
+	if err != nil {
+		return tendermint.Header{}, 0, err
+	}
+
+	header := tendermint.Header{
+		SignedHeader: commit.SignedHeader,
+		ValidatorSet: tmtypes.NewValidatorSet(validators.Validators),
+	}
+
+	return header, height, nil
+}
+
+// QueryNodeConsensusState returns the consensus state of the node queried,
+// derived from its latest committed header. This is fed into the
+// `create` CLI command to bootstrap a new Tendermint client.
+func QueryNodeConsensusState(cliCtx context.CLIContext) (exported.ConsensusState, int64, error) {
+	header, height, err := QueryTendermintHeader(cliCtx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	consensusState := tendermint.NewConsensusState(
+		header.Time, commitmenttypes.NewMerkleRoot(header.AppHash), header.NextValidatorsHash,
+	)
+
+	return consensusState, height, nil
+}
+
+// QueryUpgradeProof queries for the Merkle proof that the counterparty
+// committed to a value under the given upgrade store key, at the provided
+// height. It is used by the client `upgrade` CLI command to automatically
+// fetch `proof_upgrade_client` and `proof_upgrade_consensus_state` against
+// the counterparty's upgrade store when they are not supplied by the caller.
+func QueryUpgradeProof(cliCtx context.CLIContext, key []byte, height uint64) ([]byte, uint64, error) {
+	req := abci.RequestQuery{
+		Path:   "store/upgrade/key",
+		Data:   key,
+		Height: int64(height),
+		Prove:  true,
+	}
+
+	res, err := cliCtx.QueryABCI(req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	merkleProof := ics23.MerkleProof{
+		Proof: res.Proof,
+	}
+
+	proofBz, err := cliCtx.Codec.MarshalBinaryBare(merkleProof)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return proofBz, uint64(res.Height), nil
+}
@@ -0,0 +1,110 @@
+package exported
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+)
+
+// ClientType defines the type of the light client, used to disambiguate
+// concrete implementations that are registered behind the same interfaces.
+type ClientType string
+
+const (
+	Tendermint  ClientType = "tendermint"
+	SoloMachine ClientType = "solo-machine"
+)
+
+// String implements the Stringer interface
+func (ct ClientType) String() string {
+	return string(ct)
+}
+
+// ClientState defines the required methods for a concrete client state. A
+// light client module (e.g. Tendermint, solo machine) implements this
+// interface so it can be driven generically by the 02-client submodule
+// without the latter knowing anything about the underlying consensus
+// algorithm.
+type ClientState interface {
+	proto.Message
+
+	ClientType() ClientType
+	GetLatestHeight() uint64
+	IsFrozen() bool
+	GetFrozenHeight() uint64
+	Validate() error
+
+	// ZeroCustomFields returns a copy of the client state with all client
+	// customizable fields zeroed out, used when verifying upgrades.
+	ZeroCustomFields() ClientState
+
+	// Initialize is called upon client creation, it allows the client to
+	// perform validation on the initial consensus state and set any
+	// client-specific metadata.
+	Initialize(sdk.Context, codec.BinaryMarshaler, sdk.KVStore, ConsensusState) error
+
+	VerifyClientState(
+		store sdk.KVStore, cdc codec.BinaryMarshaler, height uint64,
+		prefix commitmentexported.Prefix, counterpartyClientIdentifier string,
+		proof []byte, clientState ClientState,
+	) error
+	VerifyClientConsensusState(
+		store sdk.KVStore, cdc codec.BinaryMarshaler, height uint64,
+		counterpartyClientIdentifier string, consensusHeight uint64,
+		prefix commitmentexported.Prefix, proof []byte, consensusState ConsensusState,
+	) error
+
+	// CheckHeaderAndUpdateState validates an incoming header and, if valid,
+	// returns the updated client and consensus states.
+	CheckHeaderAndUpdateState(
+		ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, header Header,
+	) (ClientState, ConsensusState, error)
+
+	// CheckMisbehaviourAndUpdateState checks the given misbehaviour evidence
+	// and, if valid, returns the frozen client state.
+	CheckMisbehaviourAndUpdateState(
+		ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, misbehaviour Misbehaviour,
+	) (ClientState, error)
+
+	// VerifyUpgradeAndUpdateState verifies the upgraded client and consensus
+	// states against the proofs committed to by the old client at the last
+	// height before the planned upgrade, returning the states to be stored on
+	// success.
+	VerifyUpgradeAndUpdateState(
+		ctx sdk.Context, cdc codec.BinaryMarshaler, store sdk.KVStore,
+		newClient ClientState, newConsState ConsensusState,
+		proofUpgradeClient, proofUpgradeConsState []byte,
+	) (ClientState, ConsensusState, error)
+}
+
+// ConsensusState defines the required methods for a concrete consensus state
+type ConsensusState interface {
+	proto.Message
+
+	ClientType() ClientType
+	GetRoot() commitmentexported.Root
+	GetTimestamp() uint64
+	ValidateBasic() error
+}
+
+// Header defines the required methods for a concrete header type, submitted
+// to a client to update its latest consensus state.
+type Header interface {
+	proto.Message
+
+	ClientType() ClientType
+	GetHeight() uint64
+	ValidateBasic() error
+}
+
+// Misbehaviour defines the required methods for a concrete misbehaviour
+// type, submitted as evidence that a client's consensus has been violated.
+type Misbehaviour interface {
+	proto.Message
+
+	ClientType() ClientType
+	GetClientID() string
+	ValidateBasic() error
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// NewHandler creates a new Handler for all IBC client messages, routing each
+// to the matching Keeper method.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case *types.MsgCreateClient:
+			return handleMsgCreateClient(ctx, k, msg)
+
+		case *types.MsgUpdateClient:
+			return handleMsgUpdateClient(ctx, k, msg)
+
+		case *types.MsgSubmitMisbehaviour:
+			return handleMsgSubmitMisbehaviour(ctx, k, msg)
+
+		case *types.MsgUpgradeClient:
+			return handleMsgUpgradeClient(ctx, k, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.SubModuleName, msg)
+		}
+	}
+}
+
+func handleMsgCreateClient(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCreateClient) (*sdk.Result, error) {
+	clientState, err := types.UnpackClientState(msg.ClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusState, err := types.UnpackConsensusState(msg.ConsensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.CreateClient(ctx, msg.ClientId, clientState, consensusState); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events().ToABCIEvents()}, nil
+}
+
+func handleMsgUpdateClient(ctx sdk.Context, k keeper.Keeper, msg *types.MsgUpdateClient) (*sdk.Result, error) {
+	header, err := types.UnpackHeader(msg.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.UpdateClient(ctx, msg.ClientId, header); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events().ToABCIEvents()}, nil
+}
+
+func handleMsgSubmitMisbehaviour(ctx sdk.Context, k keeper.Keeper, msg *types.MsgSubmitMisbehaviour) (*sdk.Result, error) {
+	misbehaviour, err := types.UnpackMisbehaviour(msg.Misbehaviour)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SubmitMisbehaviour(ctx, msg.ClientId, misbehaviour); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events().ToABCIEvents()}, nil
+}
+
+func handleMsgUpgradeClient(ctx sdk.Context, k keeper.Keeper, msg *types.MsgUpgradeClient) (*sdk.Result, error) {
+	clientState, err := types.UnpackClientState(msg.ClientState)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusState, err := types.UnpackConsensusState(msg.ConsensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.UpgradeClient(
+		ctx, msg.ClientId, clientState, consensusState, msg.ProofUpgradeClient, msg.ProofUpgradeConsensusState,
+	); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events().ToABCIEvents()}, nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// CreateClient creates a new client state and populates it with the initial
+// consensus state, returning an error if a client already exists under the
+// given ID.
+func (k Keeper) CreateClient(
+	ctx sdk.Context, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState,
+) error {
+	if _, found := k.GetClientState(ctx, clientID); found {
+		return sdkerrors.Wrapf(types.ErrClientExists, "cannot create client with ID %s", clientID)
+	}
+
+	clientStore := k.ClientStore(ctx, clientID)
+
+	if err := clientState.Initialize(ctx, k.cdc, clientStore, consensusState); err != nil {
+		return sdkerrors.Wrapf(err, "cannot create client with ID %s", clientID)
+	}
+
+	k.SetClientState(ctx, clientID, clientState)
+	k.SetClientConsensusState(ctx, clientID, clientState.GetLatestHeight(), consensusState)
+
+	k.Logger(ctx).Info("client created", "client-id", clientID, "height", clientState.GetLatestHeight())
+
+	return nil
+}
+
+// UpdateClient validates the given header against the existing client and, on
+// success, persists the updated client and consensus states.
+func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.Header) error {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
+	}
+
+	if clientState.IsFrozen() {
+		return sdkerrors.Wrapf(types.ErrClientFrozen, "cannot update client with ID %s", clientID)
+	}
+
+	clientStore := k.ClientStore(ctx, clientID)
+
+	newClientState, newConsState, err := clientState.CheckHeaderAndUpdateState(ctx, k.cdc, clientStore, header)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
+	}
+
+	k.SetClientState(ctx, clientID, newClientState)
+	k.SetClientConsensusState(ctx, clientID, newClientState.GetLatestHeight(), newConsState)
+
+	k.Logger(ctx).Info(
+		"client state updated", "client-id", clientID, "height", newClientState.GetLatestHeight(),
+	)
+
+	return nil
+}
+
+// SubmitMisbehaviour checks the given misbehaviour evidence against the
+// existing client and, if valid, persists the frozen client state returned
+// by the light client implementation.
+func (k Keeper) SubmitMisbehaviour(ctx sdk.Context, clientID string, misbehaviour exported.Misbehaviour) error {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot submit misbehaviour for client with ID %s", clientID)
+	}
+
+	clientStore := k.ClientStore(ctx, clientID)
+
+	newClientState, err := clientState.CheckMisbehaviourAndUpdateState(ctx, k.cdc, clientStore, misbehaviour)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot submit misbehaviour for client with ID %s", clientID)
+	}
+
+	k.SetClientState(ctx, clientID, newClientState)
+
+	k.Logger(ctx).Info(
+		"client frozen from misbehaviour", "client-id", clientID, "height", newClientState.GetFrozenHeight(),
+	)
+
+	return nil
+}
+
+// UpgradeClient upgrades the client to a new client state after verifying the
+// proofs of the new client state and consensus state committed to by the old
+// client at the last height before the planned upgrade. It atomically
+// replaces the stored client and latest consensus state on success, allowing
+// a client to follow its counterparty across a coordinated chain upgrade
+// (e.g. a hard fork or a IBC-breaking genesis restart) without freezing.
+func (k Keeper) UpgradeClient(
+	ctx sdk.Context,
+	clientID string,
+	upgradedClient exported.ClientState,
+	upgradedConsState exported.ConsensusState,
+	proofUpgradeClient,
+	proofUpgradeConsState []byte,
+) error {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot upgrade client with ID %s", clientID)
+	}
+
+	if clientState.IsFrozen() {
+		return sdkerrors.Wrapf(types.ErrClientFrozen, "cannot upgrade client with ID %s", clientID)
+	}
+
+	clientStore := k.ClientStore(ctx, clientID)
+
+	newClientState, newConsState, err := clientState.VerifyUpgradeAndUpdateState(
+		ctx, k.cdc, clientStore, upgradedClient, upgradedConsState, proofUpgradeClient, proofUpgradeConsState,
+	)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot upgrade client with ID %s", clientID)
+	}
+
+	k.SetClientState(ctx, clientID, newClientState)
+	k.SetClientConsensusState(ctx, clientID, newClientState.GetLatestHeight(), newConsState)
+
+	k.Logger(ctx).Info(
+		"client state upgraded", "client-id", clientID, "height", newClientState.GetLatestHeight(),
+	)
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	solomachine "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/solomachine/types"
+)
+
+// setupTestKeeper returns a Keeper backed by an in-memory IAVL store and a
+// proto codec with the solo machine light client and crypto PubKey
+// interfaces registered.
+func setupTestKeeper(t *testing.T) (sdk.Context, keeper.Keeper, codec.BinaryMarshaler) {
+	t.Helper()
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	k := keeper.NewKeeper(cdc, key)
+
+	return ctx, k, cdc
+}
+
+// newSoloMachineState builds a fresh, unfrozen solo machine client and
+// consensus state pair authorized by privKey.
+func newSoloMachineState(t *testing.T, privKey cryptotypes.PrivKey, sequence uint64) (*solomachine.ClientState, *solomachine.ConsensusState) {
+	t.Helper()
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
+
+	consState := solomachine.NewConsensusState(pubKeyAny, "diversifier", 10)
+	return solomachine.NewClientState(sequence, consState, false), consState
+}
+
+// sign produces a raw signature, by privKey, over the marshaled SignBytes
+// for the given sequence/timestamp/diversifier/path/data.
+func sign(t *testing.T, cdc codec.BinaryMarshaler, privKey cryptotypes.PrivKey, sequence, timestamp uint64, diversifier string, path, data []byte) []byte {
+	t.Helper()
+
+	signBytes := solomachine.SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		Path:        path,
+		Data:        data,
+	}
+
+	sig, err := privKey.Sign(cdc.MustMarshalBinaryBare(&signBytes))
+	require.NoError(t, err)
+
+	return sig
+}
+
+// signTimestamped wraps sign's output in a marshaled TimestampedSignature,
+// the proof format expected by VerifyUpgradeAndUpdateState.
+func signTimestamped(t *testing.T, cdc codec.BinaryMarshaler, privKey cryptotypes.PrivKey, sequence, timestamp uint64, diversifier string, path, data []byte) []byte {
+	t.Helper()
+
+	timestampedSig := solomachine.TimestampedSignature{
+		Signature: sign(t, cdc, privKey, sequence, timestamp, diversifier, path, data),
+		Timestamp: timestamp,
+	}
+
+	return cdc.MustMarshalBinaryBare(&timestampedSig)
+}
+
+func TestKeeperCreateClient(t *testing.T) {
+	ctx, k, _ := setupTestKeeper(t)
+	privKey := secp256k1.GenPrivKey()
+	clientState, consState := newSoloMachineState(t, privKey, 1)
+
+	err := k.CreateClient(ctx, "solomachine-0", clientState, consState)
+	require.NoError(t, err)
+
+	storedClient, found := k.GetClientState(ctx, "solomachine-0")
+	require.True(t, found)
+	require.Equal(t, clientState.ClientType(), storedClient.ClientType())
+
+	// creating a second client under the same ID is rejected
+	err = k.CreateClient(ctx, "solomachine-0", clientState, consState)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrClientExists))
+}
+
+func TestKeeperUpdateClient(t *testing.T) {
+	ctx, k, cdc := setupTestKeeper(t)
+	privKey := secp256k1.GenPrivKey()
+	clientState, consState := newSoloMachineState(t, privKey, 1)
+
+	err := k.CreateClient(ctx, "solomachine-0", clientState, consState)
+	require.NoError(t, err)
+
+	newPrivKey := secp256k1.GenPrivKey()
+	newPubKeyAny, err := codectypes.NewAnyWithValue(newPrivKey.PubKey())
+	require.NoError(t, err)
+
+	newConsState := solomachine.NewConsensusState(newPubKeyAny, "new-diversifier", 11)
+	newConsStateBz := cdc.MustMarshalBinaryBare(newConsState)
+
+	header := solomachine.NewHeader(
+		1, 11,
+		sign(t, cdc, privKey, 1, 11, consState.Diversifier, []byte("updateClient"), newConsStateBz),
+		newPubKeyAny, "new-diversifier",
+	)
+
+	err = k.UpdateClient(ctx, "solomachine-0", header)
+	require.NoError(t, err)
+
+	updated, found := k.GetClientState(ctx, "solomachine-0")
+	require.True(t, found)
+	require.Equal(t, uint64(2), updated.GetLatestHeight())
+
+	// updating a client that doesn't exist fails
+	err = k.UpdateClient(ctx, "solomachine-1", header)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrClientNotFound))
+}
+
+func TestKeeperSubmitMisbehaviour(t *testing.T) {
+	ctx, k, cdc := setupTestKeeper(t)
+	privKey := secp256k1.GenPrivKey()
+	clientState, consState := newSoloMachineState(t, privKey, 1)
+
+	err := k.CreateClient(ctx, "solomachine-0", clientState, consState)
+	require.NoError(t, err)
+
+	misbehaviour := solomachine.NewMisbehaviour("solomachine-0", 1,
+		&solomachine.SignatureAndData{
+			Signature: sign(t, cdc, privKey, 1, 10, consState.Diversifier, []byte("path"), []byte("data-one")),
+			Path:      []byte("path"),
+			Data:      []byte("data-one"),
+			Timestamp: 10,
+		},
+		&solomachine.SignatureAndData{
+			Signature: sign(t, cdc, privKey, 1, 10, consState.Diversifier, []byte("path"), []byte("data-two")),
+			Path:      []byte("path"),
+			Data:      []byte("data-two"),
+			Timestamp: 10,
+		},
+	)
+
+	err = k.SubmitMisbehaviour(ctx, "solomachine-0", misbehaviour)
+	require.NoError(t, err)
+
+	frozen, found := k.GetClientState(ctx, "solomachine-0")
+	require.True(t, found)
+	require.True(t, frozen.IsFrozen())
+
+	// submitting misbehaviour against a client that doesn't exist fails
+	err = k.SubmitMisbehaviour(ctx, "solomachine-1", misbehaviour)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrClientNotFound))
+}
+
+func TestKeeperUpgradeClientNotFound(t *testing.T) {
+	ctx, k, _ := setupTestKeeper(t)
+	privKey := secp256k1.GenPrivKey()
+	clientState, consState := newSoloMachineState(t, privKey, 1)
+
+	err := k.UpgradeClient(ctx, "solomachine-0", clientState, consState, []byte("proof"), []byte("proof"))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrClientNotFound))
+}
+
+func TestKeeperUpgradeClient(t *testing.T) {
+	ctx, k, cdc := setupTestKeeper(t)
+	privKey := secp256k1.GenPrivKey()
+	clientState, consState := newSoloMachineState(t, privKey, 1)
+
+	err := k.CreateClient(ctx, "solomachine-0", clientState, consState)
+	require.NoError(t, err)
+
+	newPrivKey := secp256k1.GenPrivKey()
+	newClientState, newConsState := newSoloMachineState(t, newPrivKey, 1)
+
+	clientStateBz := cdc.MustMarshalBinaryBare(newClientState)
+	consStateBz := cdc.MustMarshalBinaryBare(newConsState)
+
+	proofUpgradeClient := signTimestamped(t, cdc, privKey, 1, 10, consState.Diversifier, []byte("upgradeClient"), clientStateBz)
+	proofUpgradeConsState := signTimestamped(t, cdc, privKey, 1, 10, consState.Diversifier, []byte("upgradeConsensusState"), consStateBz)
+
+	err = k.UpgradeClient(ctx, "solomachine-0", newClientState, newConsState, proofUpgradeClient, proofUpgradeConsState)
+	require.NoError(t, err)
+
+	upgraded, found := k.GetClientState(ctx, "solomachine-0")
+	require.True(t, found)
+	require.Equal(t, uint64(2), upgraded.GetLatestHeight())
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// Keeper represents a type that grants read and write permissions to the
+// IBC client sub-module
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+}
+
+// NewKeeper creates a new NewKeeper instance
+func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+		cdc:      cdc,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"/"+types.SubModuleName)
+}
+
+// GetClientState gets a particular client from the store
+func (k Keeper) GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.FullClientStateKey(clientID))
+	if bz == nil {
+		return nil, false
+	}
+
+	return k.MustUnmarshalClientState(bz), true
+}
+
+// SetClientState sets a particular client to the store
+func (k Keeper) SetClientState(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.FullClientStateKey(clientID), k.MustMarshalClientState(clientState))
+}
+
+// GetClientConsensusState gets the consensus state of a particular client at the given height
+func (k Keeper) GetClientConsensusState(ctx sdk.Context, clientID string, height uint64) (exported.ConsensusState, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.FullConsensusStateKey(clientID, height))
+	if bz == nil {
+		return nil, false
+	}
+
+	return k.MustUnmarshalConsensusState(bz), true
+}
+
+// SetClientConsensusState sets the consensus state of a particular client at the given height
+func (k Keeper) SetClientConsensusState(ctx sdk.Context, clientID string, height uint64, consensusState exported.ConsensusState) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.FullConsensusStateKey(clientID, height), k.MustMarshalConsensusState(consensusState))
+}
+
+// ClientStore returns a prefix store for a particular client, used by a
+// client's own `VerifyXxx` and `CheckHeaderAndUpdateState` implementations
+// to read and write client-specific metadata.
+func (k Keeper) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), host.FullClientPrefix(clientID))
+}
+
+// MustMarshalClientState attempts to encode an ClientState object and returns the
+// raw encoded bytes. It panics on error. ClientState is an interface backed by
+// any number of concrete light client implementations, so it is packed as an
+// Any through MarshalInterface rather than the concrete-type-oriented
+// MarshalBinaryBare.
+func (k Keeper) MustMarshalClientState(clientState exported.ClientState) []byte {
+	bz, err := k.cdc.MarshalInterface(clientState)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// MustUnmarshalClientState attempts to decode and return an ClientState object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalClientState(bz []byte) exported.ClientState {
+	var clientState exported.ClientState
+	if err := k.cdc.UnmarshalInterface(bz, &clientState); err != nil {
+		panic(err)
+	}
+	return clientState
+}
+
+// MustMarshalConsensusState attempts to encode a ConsensusState object and returns the
+// raw encoded bytes. It panics on error. Like ClientState, ConsensusState is
+// packed as an Any through MarshalInterface so the concrete type can be
+// recovered on read via the InterfaceRegistry.
+func (k Keeper) MustMarshalConsensusState(consensusState exported.ConsensusState) []byte {
+	bz, err := k.cdc.MarshalInterface(consensusState)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// MustUnmarshalConsensusState attempts to decode and return a ConsensusState object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalConsensusState(bz []byte) exported.ConsensusState {
+	var consensusState exported.ConsensusState
+	if err := k.cdc.UnmarshalInterface(bz, &consensusState); err != nil {
+		panic(err)
+	}
+	return consensusState
+}
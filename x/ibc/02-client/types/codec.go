@@ -0,0 +1,72 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	solomachine "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/solomachine/types"
+	tendermint "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/tendermint/types"
+)
+
+// SubModuleCdc references the global x/ibc/02-client module codec. Note, the
+// codec should ONLY be used in certain instances of tests and for JSON
+// encoding as Amino is still used for that purpose.
+//
+// The actual codec used for serialization should be provided to x/ibc/02-client and
+// defined at the application level.
+var SubModuleCdc = codec.New()
+
+func init() {
+	RegisterLegacyAminoCodec(SubModuleCdc)
+	cryptocodec.RegisterCrypto(SubModuleCdc)
+	sdk.RegisterCodec(SubModuleCdc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/ibc/02-client interfaces and
+// concrete types on the provided Amino codec. These types are used for Amino
+// JSON signing.
+func RegisterLegacyAminoCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateClient{}, "ibc/client/MsgCreateClient", nil)
+	cdc.RegisterConcrete(MsgUpdateClient{}, "ibc/client/MsgUpdateClient", nil)
+	cdc.RegisterConcrete(MsgSubmitMisbehaviour{}, "ibc/client/MsgSubmitMisbehaviour", nil)
+	cdc.RegisterConcrete(MsgUpgradeClient{}, "ibc/client/MsgUpgradeClient", nil)
+}
+
+// RegisterInterfaces registers the client interfaces to the protobuf Any
+// interface registry used by the 02-client submodule. Concrete light client
+// implementations (Tendermint, solo machine, ...) register their own
+// ClientState, ConsensusState, Header and Misbehaviour implementations
+// against these same interfaces so that `UnmarshalInterfaceJSON` can resolve
+// the `@type` field of any client-specific payload.
+//
+// The Msg* types in this package are hand-written Amino types (registered
+// above via RegisterLegacyAminoCodec) rather than generated protobuf
+// messages, so they are not registered here: RegisterImplementations
+// requires a proto.Message, which they do not implement.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"cosmos_sdk.ibc.v1.ClientState",
+		(*exported.ClientState)(nil),
+	)
+	registry.RegisterInterface(
+		"cosmos_sdk.ibc.v1.ConsensusState",
+		(*exported.ConsensusState)(nil),
+	)
+	registry.RegisterInterface(
+		"cosmos_sdk.ibc.v1.Header",
+		(*exported.Header)(nil),
+	)
+	registry.RegisterInterface(
+		"cosmos_sdk.ibc.v1.Misbehaviour",
+		(*exported.Misbehaviour)(nil),
+	)
+
+	// register the concrete light client implementations that ship with the
+	// SDK so that generic CLI commands accepting client/consensus state,
+	// header or misbehaviour JSON can resolve the `@type` field regardless
+	// of which client the payload targets.
+	tendermint.RegisterInterfaces(registry)
+	solomachine.RegisterInterfaces(registry)
+}
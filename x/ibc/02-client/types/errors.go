@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC client sentinel errors
+var (
+	ErrClientExists           = sdkerrors.Register(SubModuleName, 2, "light client already exists")
+	ErrClientNotFound         = sdkerrors.Register(SubModuleName, 3, "light client not found")
+	ErrClientFrozen           = sdkerrors.Register(SubModuleName, 4, "light client is frozen due to misbehaviour")
+	ErrConsensusStateNotFound = sdkerrors.Register(SubModuleName, 5, "consensus state not found")
+	ErrInvalidConsensus       = sdkerrors.Register(SubModuleName, 6, "invalid consensus state")
+	ErrClientTypeNotFound     = sdkerrors.Register(SubModuleName, 7, "client type not found")
+	ErrInvalidClientType      = sdkerrors.Register(SubModuleName, 8, "invalid client type")
+	ErrInvalidHeader          = sdkerrors.Register(SubModuleName, 9, "invalid header")
+	ErrInvalidMisbehaviour    = sdkerrors.Register(SubModuleName, 10, "invalid misbehaviour")
+	ErrInvalidHeight          = sdkerrors.Register(SubModuleName, 11, "invalid height")
+)
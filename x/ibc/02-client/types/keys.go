@@ -0,0 +1,20 @@
+package types
+
+const (
+	// SubModuleName defines the IBC client name
+	SubModuleName string = "client"
+
+	// StoreKey is the store key string for the IBC client
+	StoreKey = SubModuleName
+
+	// QuerierRoute is the querier route for the IBC client
+	QuerierRoute = SubModuleName
+
+	// KeyUpgradeClient is the key under which an upgraded client state is
+	// stored in the upgrade store ahead of a planned upgrade height
+	KeyUpgradeClient = "upgradedClient"
+
+	// KeyUpgradeConsState is the key under which an upgraded consensus
+	// state is stored in the upgrade store ahead of a planned upgrade height
+	KeyUpgradeConsState = "upgradedConsState"
+)
@@ -0,0 +1,278 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// msg types for the ibc client
+const (
+	TypeMsgCreateClient       = "create_client"
+	TypeMsgUpdateClient       = "update_client"
+	TypeMsgSubmitMisbehaviour = "submit_misbehaviour"
+	TypeMsgUpgradeClient      = "upgrade_client"
+)
+
+// Message types for the IBC client
+var (
+	_ sdk.Msg = &MsgCreateClient{}
+	_ sdk.Msg = &MsgUpdateClient{}
+	_ sdk.Msg = &MsgSubmitMisbehaviour{}
+	_ sdk.Msg = &MsgUpgradeClient{}
+)
+
+// MsgCreateClient defines a message to create an IBC client. The client and
+// consensus state are stored as opaque `Any` types so that the message is
+// agnostic to the concrete light client implementation being instantiated.
+type MsgCreateClient struct {
+	ClientId       string         `json:"client_id" yaml:"client_id"`
+	ClientState    *types.Any     `json:"client_state" yaml:"client_state"`
+	ConsensusState *types.Any     `json:"consensus_state" yaml:"consensus_state"`
+	Signer         sdk.AccAddress `json:"signer" yaml:"signer"`
+}
+
+// NewMsgCreateClient creates a new MsgCreateClient instance
+func NewMsgCreateClient(
+	clientID string, clientState exported.ClientState, consensusState exported.ConsensusState, signer sdk.AccAddress,
+) (*MsgCreateClient, error) {
+	anyClientState, err := types.NewAnyWithValue(clientState)
+	if err != nil {
+		return nil, err
+	}
+
+	anyConsensusState, err := types.NewAnyWithValue(consensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateClient{
+		ClientId:       clientID,
+		ClientState:    anyClientState,
+		ConsensusState: anyConsensusState,
+		Signer:         signer,
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateClient) Route() string { return host.RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateClient) Type() string { return TypeMsgCreateClient }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateClient) ValidateBasic() error {
+	if strings.TrimSpace(msg.ClientId) == "" {
+		return sdkerrors.Wrap(host.ErrInvalidID, "client id cannot be blank")
+	}
+	if msg.ClientState == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "client state cannot be nil")
+	}
+	if msg.ConsensusState == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "consensus state cannot be nil")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgUpdateClient defines a message to update an IBC client with a new
+// header. The header is opaque to 02-client and is unpacked by the
+// concrete light client implementation referenced by ClientId.
+type MsgUpdateClient struct {
+	ClientId string         `json:"client_id" yaml:"client_id"`
+	Header   *types.Any     `json:"header" yaml:"header"`
+	Signer   sdk.AccAddress `json:"signer" yaml:"signer"`
+}
+
+// NewMsgUpdateClient creates a new MsgUpdateClient instance
+func NewMsgUpdateClient(clientID string, header exported.Header, signer sdk.AccAddress) (*MsgUpdateClient, error) {
+	anyHeader, err := types.NewAnyWithValue(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgUpdateClient{
+		ClientId: clientID,
+		Header:   anyHeader,
+		Signer:   signer,
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateClient) Route() string { return host.RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateClient) Type() string { return TypeMsgUpdateClient }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateClient) ValidateBasic() error {
+	if strings.TrimSpace(msg.ClientId) == "" {
+		return sdkerrors.Wrap(host.ErrInvalidID, "client id cannot be blank")
+	}
+	if msg.Header == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "header cannot be nil")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgSubmitMisbehaviour defines a message to submit evidence that a client's
+// consensus has been violated, freezing the client on success.
+type MsgSubmitMisbehaviour struct {
+	ClientId     string         `json:"client_id" yaml:"client_id"`
+	Misbehaviour *types.Any     `json:"misbehaviour" yaml:"misbehaviour"`
+	Signer       sdk.AccAddress `json:"signer" yaml:"signer"`
+}
+
+// NewMsgSubmitMisbehaviour creates a new MsgSubmitMisbehaviour instance
+func NewMsgSubmitMisbehaviour(
+	clientID string, misbehaviour exported.Misbehaviour, signer sdk.AccAddress,
+) (*MsgSubmitMisbehaviour, error) {
+	anyMisbehaviour, err := types.NewAnyWithValue(misbehaviour)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitMisbehaviour{
+		ClientId:     clientID,
+		Misbehaviour: anyMisbehaviour,
+		Signer:       signer,
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (msg MsgSubmitMisbehaviour) Route() string { return host.RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSubmitMisbehaviour) Type() string { return TypeMsgSubmitMisbehaviour }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSubmitMisbehaviour) ValidateBasic() error {
+	if strings.TrimSpace(msg.ClientId) == "" {
+		return sdkerrors.Wrap(host.ErrInvalidID, "client id cannot be blank")
+	}
+	if msg.Misbehaviour == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "misbehaviour cannot be nil")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSubmitMisbehaviour) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSubmitMisbehaviour) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgUpgradeClient defines a message to upgrade an IBC client to a new
+// client state, used to carry a client across a counterparty chain-id or
+// height discontinuity such as a coordinated hard-fork. ProofUpgradeClient
+// and ProofUpgradeConsensusState are Merkle proofs, taken at the last height
+// before the upgrade, that the counterparty committed to the new client and
+// consensus state in its upgrade store.
+type MsgUpgradeClient struct {
+	ClientId                   string         `json:"client_id" yaml:"client_id"`
+	ClientState                *types.Any     `json:"client_state" yaml:"client_state"`
+	ConsensusState             *types.Any     `json:"consensus_state" yaml:"consensus_state"`
+	ProofUpgradeClient         []byte         `json:"proof_upgrade_client" yaml:"proof_upgrade_client"`
+	ProofUpgradeConsensusState []byte         `json:"proof_upgrade_consensus_state" yaml:"proof_upgrade_consensus_state"`
+	Signer                     sdk.AccAddress `json:"signer" yaml:"signer"`
+}
+
+// NewMsgUpgradeClient creates a new MsgUpgradeClient instance
+func NewMsgUpgradeClient(
+	clientID string, clientState exported.ClientState, consensusState exported.ConsensusState,
+	proofUpgradeClient, proofUpgradeConsState []byte, signer sdk.AccAddress,
+) (*MsgUpgradeClient, error) {
+	anyClient, err := types.NewAnyWithValue(clientState)
+	if err != nil {
+		return nil, err
+	}
+
+	anyConsState, err := types.NewAnyWithValue(consensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgUpgradeClient{
+		ClientId:                   clientID,
+		ClientState:                anyClient,
+		ConsensusState:             anyConsState,
+		ProofUpgradeClient:         proofUpgradeClient,
+		ProofUpgradeConsensusState: proofUpgradeConsState,
+		Signer:                     signer,
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpgradeClient) Route() string { return host.RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpgradeClient) Type() string { return TypeMsgUpgradeClient }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpgradeClient) ValidateBasic() error {
+	if strings.TrimSpace(msg.ClientId) == "" {
+		return sdkerrors.Wrap(host.ErrInvalidID, "client id cannot be blank")
+	}
+	if msg.ClientState == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "client state cannot be nil")
+	}
+	if msg.ConsensusState == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "consensus state cannot be nil")
+	}
+	if len(msg.ProofUpgradeClient) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proof of client upgrade cannot be empty")
+	}
+	if len(msg.ProofUpgradeConsensusState) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proof of consensus state upgrade cannot be empty")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpgradeClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpgradeClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
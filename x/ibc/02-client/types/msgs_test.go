@@ -0,0 +1,250 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	solomachine "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/solomachine/types"
+)
+
+// newTestClientAndConsState builds a solo machine client/consensus state
+// pair, used throughout this file to exercise the generic, client-agnostic
+// Msg types against a concrete exported.ClientState/ConsensusState.
+func newTestClientAndConsState(t *testing.T) (*solomachine.ClientState, *solomachine.ConsensusState) {
+	t.Helper()
+
+	privKey := secp256k1.GenPrivKey()
+	pubKeyAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
+
+	consState := solomachine.NewConsensusState(pubKeyAny, "diversifier", 10)
+	clientState := solomachine.NewClientState(1, consState, false)
+
+	return clientState, consState
+}
+
+func TestMsgCreateClientValidateBasic(t *testing.T) {
+	clientState, consState := newTestClientAndConsState(t)
+	signer := sdk.AccAddress("testaddr____________")
+
+	testCases := []struct {
+		name    string
+		msgFunc func() (*types.MsgCreateClient, error)
+		expPass bool
+	}{
+		{
+			"success",
+			func() (*types.MsgCreateClient, error) {
+				return types.NewMsgCreateClient("solomachine-0", clientState, consState, signer)
+			},
+			true,
+		},
+		{
+			"blank client id",
+			func() (*types.MsgCreateClient, error) {
+				return types.NewMsgCreateClient("", clientState, consState, signer)
+			},
+			false,
+		},
+		{
+			"empty signer",
+			func() (*types.MsgCreateClient, error) {
+				return types.NewMsgCreateClient("solomachine-0", clientState, consState, sdk.AccAddress{})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := tc.msgFunc()
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateClientValidateBasic(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	newPubKeyAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
+
+	header := solomachine.NewHeader(1, 10, []byte("signature"), newPubKeyAny, "new-diversifier")
+	signer := sdk.AccAddress("testaddr____________")
+
+	testCases := []struct {
+		name    string
+		msgFunc func() (*types.MsgUpdateClient, error)
+		expPass bool
+	}{
+		{
+			"success",
+			func() (*types.MsgUpdateClient, error) {
+				return types.NewMsgUpdateClient("solomachine-0", header, signer)
+			},
+			true,
+		},
+		{
+			"blank client id",
+			func() (*types.MsgUpdateClient, error) {
+				return types.NewMsgUpdateClient("", header, signer)
+			},
+			false,
+		},
+		{
+			"empty signer",
+			func() (*types.MsgUpdateClient, error) {
+				return types.NewMsgUpdateClient("solomachine-0", header, sdk.AccAddress{})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := tc.msgFunc()
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSubmitMisbehaviourValidateBasic(t *testing.T) {
+	misbehaviour := solomachine.NewMisbehaviour("solomachine-0", 1, &solomachine.SignatureAndData{
+		Signature: []byte("signature-one"),
+		Path:      []byte("path"),
+		Data:      []byte("data-one"),
+		Timestamp: 10,
+	}, &solomachine.SignatureAndData{
+		Signature: []byte("signature-two"),
+		Path:      []byte("path"),
+		Data:      []byte("data-two"),
+		Timestamp: 10,
+	})
+	signer := sdk.AccAddress("testaddr____________")
+
+	testCases := []struct {
+		name    string
+		msgFunc func() (*types.MsgSubmitMisbehaviour, error)
+		expPass bool
+	}{
+		{
+			"success",
+			func() (*types.MsgSubmitMisbehaviour, error) {
+				return types.NewMsgSubmitMisbehaviour("solomachine-0", misbehaviour, signer)
+			},
+			true,
+		},
+		{
+			"blank client id",
+			func() (*types.MsgSubmitMisbehaviour, error) {
+				return types.NewMsgSubmitMisbehaviour("", misbehaviour, signer)
+			},
+			false,
+		},
+		{
+			"empty signer",
+			func() (*types.MsgSubmitMisbehaviour, error) {
+				return types.NewMsgSubmitMisbehaviour("solomachine-0", misbehaviour, sdk.AccAddress{})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := tc.msgFunc()
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpgradeClientValidateBasic(t *testing.T) {
+	clientState, consState := newTestClientAndConsState(t)
+	signer := sdk.AccAddress("testaddr____________")
+	proof := []byte("proof")
+
+	testCases := []struct {
+		name    string
+		msgFunc func() (*types.MsgUpgradeClient, error)
+		expPass bool
+	}{
+		{
+			"success",
+			func() (*types.MsgUpgradeClient, error) {
+				return types.NewMsgUpgradeClient("solomachine-0", clientState, consState, proof, proof, signer)
+			},
+			true,
+		},
+		{
+			"blank client id",
+			func() (*types.MsgUpgradeClient, error) {
+				return types.NewMsgUpgradeClient("", clientState, consState, proof, proof, signer)
+			},
+			false,
+		},
+		{
+			"empty proof of client upgrade",
+			func() (*types.MsgUpgradeClient, error) {
+				return types.NewMsgUpgradeClient("solomachine-0", clientState, consState, nil, proof, signer)
+			},
+			false,
+		},
+		{
+			"empty proof of consensus state upgrade",
+			func() (*types.MsgUpgradeClient, error) {
+				return types.NewMsgUpgradeClient("solomachine-0", clientState, consState, proof, nil, signer)
+			},
+			false,
+		},
+		{
+			"empty signer",
+			func() (*types.MsgUpgradeClient, error) {
+				return types.NewMsgUpgradeClient("solomachine-0", clientState, consState, proof, proof, sdk.AccAddress{})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := tc.msgFunc()
+			require.NoError(t, err)
+
+			err = msg.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+)
+
+// ClientStateResponse defines the client state returned by a client state
+// query, together with the Merkle proof of its storage and the height at
+// which that proof was taken.
+type ClientStateResponse struct {
+	ClientState exported.ClientState `json:"client_state" yaml:"client_state"`
+	Proof       []byte               `json:"proof,omitempty" yaml:"proof,omitempty"`
+	ProofPath   string               `json:"proof_path,omitempty" yaml:"proof_path,omitempty"`
+	ProofHeight int64                `json:"proof_height,omitempty" yaml:"proof_height,omitempty"`
+}
+
+// NewClientStateResponse creates a new ClientStateResponse instance.
+func NewClientStateResponse(
+	clientState exported.ClientState, proof []byte, proofPath string, height int64,
+) ClientStateResponse {
+	return ClientStateResponse{
+		ClientState: clientState,
+		Proof:       proof,
+		ProofPath:   proofPath,
+		ProofHeight: height,
+	}
+}
+
+// ConsensusStateResponse defines the consensus state returned by a
+// consensus state query, together with the Merkle proof of its storage and
+// the height at which that proof was taken.
+type ConsensusStateResponse struct {
+	ConsensusState exported.ConsensusState `json:"consensus_state" yaml:"consensus_state"`
+	Proof          []byte                  `json:"proof,omitempty" yaml:"proof,omitempty"`
+	ProofPath      string                  `json:"proof_path,omitempty" yaml:"proof_path,omitempty"`
+	ProofHeight    int64                   `json:"proof_height,omitempty" yaml:"proof_height,omitempty"`
+}
+
+// NewConsensusStateResponse creates a new ConsensusStateResponse instance.
+func NewConsensusStateResponse(
+	consensusState exported.ConsensusState, proof []byte, proofPath string, height int64,
+) ConsensusStateResponse {
+	return ConsensusStateResponse{
+		ConsensusState: consensusState,
+		Proof:          proof,
+		ProofPath:      proofPath,
+		ProofHeight:    height,
+	}
+}
+
+// RootResponse defines the commitment root of a client's consensus state at
+// a given height, together with the Merkle proof of its storage and the
+// height at which that proof was taken.
+type RootResponse struct {
+	Root        commitmentexported.Root `json:"root" yaml:"root"`
+	Proof       []byte                  `json:"proof,omitempty" yaml:"proof,omitempty"`
+	ProofPath   string                  `json:"proof_path,omitempty" yaml:"proof_path,omitempty"`
+	ProofHeight int64                   `json:"proof_height,omitempty" yaml:"proof_height,omitempty"`
+}
+
+// NewRootResponse creates a new RootResponse instance.
+func NewRootResponse(root commitmentexported.Root, proof []byte, proofPath string, height int64) RootResponse {
+	return RootResponse{
+		Root:        root,
+		Proof:       proof,
+		ProofPath:   proofPath,
+		ProofHeight: height,
+	}
+}
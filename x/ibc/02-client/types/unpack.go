@@ -0,0 +1,69 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// UnpackClientState unpacks an Any into a ClientState. It assumes the Any's
+// cached value has already been set, either by the interface registry while
+// decoding the enclosing Msg or by codectypes.NewAnyWithValue when the Msg
+// was constructed.
+func UnpackClientState(any *types.Any) (exported.ClientState, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "protobuf Any message cannot be nil")
+	}
+
+	clientState, ok := any.GetCachedValue().(exported.ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack Any into ClientState: %T", any.GetCachedValue())
+	}
+
+	return clientState, nil
+}
+
+// UnpackConsensusState unpacks an Any into a ConsensusState. See
+// UnpackClientState for the cached-value precondition.
+func UnpackConsensusState(any *types.Any) (exported.ConsensusState, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "protobuf Any message cannot be nil")
+	}
+
+	consensusState, ok := any.GetCachedValue().(exported.ConsensusState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack Any into ConsensusState: %T", any.GetCachedValue())
+	}
+
+	return consensusState, nil
+}
+
+// UnpackHeader unpacks an Any into a Header. See UnpackClientState for the
+// cached-value precondition.
+func UnpackHeader(any *types.Any) (exported.Header, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "protobuf Any message cannot be nil")
+	}
+
+	header, ok := any.GetCachedValue().(exported.Header)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack Any into Header: %T", any.GetCachedValue())
+	}
+
+	return header, nil
+}
+
+// UnpackMisbehaviour unpacks an Any into a Misbehaviour. See
+// UnpackClientState for the cached-value precondition.
+func UnpackMisbehaviour(any *types.Any) (exported.Misbehaviour, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "protobuf Any message cannot be nil")
+	}
+
+	misbehaviour, ok := any.GetCachedValue().(exported.Misbehaviour)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot unpack Any into Misbehaviour: %T", any.GetCachedValue())
+	}
+
+	return misbehaviour, nil
+}
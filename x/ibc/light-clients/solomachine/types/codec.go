@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// RegisterLegacyAminoCodec registers the solo machine types on the provided
+// Amino codec. These types are used for Amino JSON signing.
+func RegisterLegacyAminoCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(ClientState{}, "ibc/client/solomachine/ClientState", nil)
+	cdc.RegisterConcrete(ConsensusState{}, "ibc/client/solomachine/ConsensusState", nil)
+	cdc.RegisterConcrete(Header{}, "ibc/client/solomachine/Header", nil)
+	cdc.RegisterConcrete(Misbehaviour{}, "ibc/client/solomachine/Misbehaviour", nil)
+}
+
+// RegisterInterfaces registers the solo machine concrete client-related
+// implementations against the 02-client exported interfaces so that generic
+// CLI commands and the interface registry can resolve solo-machine JSON
+// without any client-specific plumbing.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*exported.ClientState)(nil),
+		&ClientState{},
+	)
+	registry.RegisterImplementations(
+		(*exported.ConsensusState)(nil),
+		&ConsensusState{},
+	)
+	registry.RegisterImplementations(
+		(*exported.Header)(nil),
+		&Header{},
+	)
+	registry.RegisterImplementations(
+		(*exported.Misbehaviour)(nil),
+		&Misbehaviour{},
+	)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// solo machine sentinel errors
+var (
+	ErrInvalidSequence             = sdkerrors.Register(SubModuleName, 2, "sequence number is invalid")
+	ErrInvalidConsensus            = sdkerrors.Register(SubModuleName, 3, "invalid consensus state")
+	ErrInvalidPublicKey            = sdkerrors.Register(SubModuleName, 4, "public key is invalid")
+	ErrInvalidDiversifier          = sdkerrors.Register(SubModuleName, 5, "diversifier is invalid")
+	ErrInvalidHeader               = sdkerrors.Register(SubModuleName, 6, "header is invalid")
+	ErrInvalidSignatureAndData     = sdkerrors.Register(SubModuleName, 7, "invalid signature and data")
+	ErrSignatureVerificationFailed = sdkerrors.Register(SubModuleName, 8, "signature verification failed")
+	ErrInvalidMisbehaviour         = sdkerrors.Register(SubModuleName, 9, "invalid misbehaviour")
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+var _ exported.Header = (*Header)(nil)
+
+// NewHeader creates a new Header instance
+func NewHeader(sequence, timestamp uint64, signature []byte, newPublicKey *types.Any, newDiversifier string) *Header {
+	return &Header{
+		Sequence:       sequence,
+		Timestamp:      timestamp,
+		Signature:      signature,
+		NewPublicKey:   newPublicKey,
+		NewDiversifier: newDiversifier,
+	}
+}
+
+// ClientType is solo machine
+func (h Header) ClientType() exported.ClientType {
+	return exported.SoloMachine
+}
+
+// GetHeight returns the sequence that this header advances the client to
+func (h Header) GetHeight() uint64 {
+	return h.Sequence
+}
+
+// ValidateBasic ensures that the sequence, timestamp, signature and new
+// public key of the header are valid. NewDiversifier may be blank: per
+// CheckHeaderAndUpdateState, a blank NewDiversifier means the header keeps
+// the client's current diversifier rather than rotating to a new one.
+func (h Header) ValidateBasic() error {
+	if h.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "sequence cannot be 0")
+	}
+	if h.Timestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "timestamp cannot be 0")
+	}
+	if len(h.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "signature cannot be empty")
+	}
+	if h.NewPublicKey == nil {
+		return sdkerrors.Wrap(ErrInvalidPublicKey, "new public key cannot be nil")
+	}
+	return nil
+}
@@ -0,0 +1,6 @@
+package types
+
+const (
+	// SubModuleName defines the solo machine light client name
+	SubModuleName = "solomachine"
+)
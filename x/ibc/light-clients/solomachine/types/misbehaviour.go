@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+var _ exported.Misbehaviour = (*Misbehaviour)(nil)
+
+// NewMisbehaviour creates a new Misbehaviour instance
+func NewMisbehaviour(clientID string, sequence uint64, signatureOne, signatureTwo *SignatureAndData) *Misbehaviour {
+	return &Misbehaviour{
+		ClientId:     clientID,
+		Sequence:     sequence,
+		SignatureOne: signatureOne,
+		SignatureTwo: signatureTwo,
+	}
+}
+
+// ClientType is solo machine
+func (m Misbehaviour) ClientType() exported.ClientType {
+	return exported.SoloMachine
+}
+
+// GetClientID returns the ID of the client that committed the misbehaviour
+func (m Misbehaviour) GetClientID() string {
+	return m.ClientId
+}
+
+// ValidateBasic ensures that both signatures are present and that they
+// conflict, i.e. sign over different data at the same sequence.
+func (m Misbehaviour) ValidateBasic() error {
+	if strings.TrimSpace(m.ClientId) == "" {
+		return sdkerrors.Wrap(host.ErrInvalidID, "client id cannot be blank")
+	}
+	if m.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidMisbehaviour, "sequence cannot be 0")
+	}
+	if m.SignatureOne == nil || m.SignatureTwo == nil {
+		return sdkerrors.Wrap(ErrInvalidMisbehaviour, "signatures cannot be nil")
+	}
+	if err := m.SignatureOne.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "signature one failed validation")
+	}
+	if err := m.SignatureTwo.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "signature two failed validation")
+	}
+
+	// the misbehaviour is only valid if both signatures commit to different
+	// data at the same path, proving the key signed two conflicting values
+	if string(m.SignatureOne.Path) != string(m.SignatureTwo.Path) ||
+		string(m.SignatureOne.Data) == string(m.SignatureTwo.Data) {
+		return sdkerrors.Wrap(ErrInvalidMisbehaviour, "signatures must commit to different data")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// CheckMisbehaviourAndUpdateState checks that the misbehaviour's sequence
+// matches the client's current sequence and that both signatures were
+// produced by the client's current public key at that sequence over
+// different values. If so, the client is frozen at that sequence.
+func (cs ClientState) CheckMisbehaviourAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, misbehaviour exported.Misbehaviour,
+) (exported.ClientState, error) {
+	smMisbehaviour, ok := misbehaviour.(*Misbehaviour)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidMisbehaviour, "misbehaviour type %T is not solo machine misbehaviour", misbehaviour)
+	}
+
+	if cs.IsFrozen() {
+		return &cs, nil
+	}
+
+	if smMisbehaviour.Sequence != cs.Sequence {
+		return nil, sdkerrors.Wrapf(
+			ErrInvalidMisbehaviour, "misbehaviour sequence %d does not match client sequence %d", smMisbehaviour.Sequence, cs.Sequence,
+		)
+	}
+
+	// both signatures must be present and commit to different data at the
+	// same path
+	if err := smMisbehaviour.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := verifySignature(
+		cdc, cs.ConsensusState.PublicKey, smMisbehaviour.Sequence, smMisbehaviour.SignatureOne.Timestamp,
+		cs.ConsensusState.Diversifier, smMisbehaviour.SignatureOne.Path, smMisbehaviour.SignatureOne.Data,
+		smMisbehaviour.SignatureOne.Signature,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to verify signature one")
+	}
+
+	if err := verifySignature(
+		cdc, cs.ConsensusState.PublicKey, smMisbehaviour.Sequence, smMisbehaviour.SignatureTwo.Timestamp,
+		cs.ConsensusState.Diversifier, smMisbehaviour.SignatureTwo.Path, smMisbehaviour.SignatureTwo.Data,
+		smMisbehaviour.SignatureTwo.Signature,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to verify signature two")
+	}
+
+	cs.FrozenSequence = smMisbehaviour.Sequence
+	return &cs, nil
+}
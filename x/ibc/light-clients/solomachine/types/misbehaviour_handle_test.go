@@ -0,0 +1,151 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/light-clients/solomachine/types"
+)
+
+func TestCheckMisbehaviourAndUpdateState(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+
+	sigOne := &types.SignatureAndData{
+		Signature: sign(t, cdc, privKey, 1, 10, "diversifier", []byte("path"), []byte("data-one")),
+		Path:      []byte("path"),
+		Data:      []byte("data-one"),
+		Timestamp: 10,
+	}
+	sigTwo := &types.SignatureAndData{
+		Signature: sign(t, cdc, privKey, 1, 10, "diversifier", []byte("path"), []byte("data-two")),
+		Path:      []byte("path"),
+		Data:      []byte("data-two"),
+		Timestamp: 10,
+	}
+	misbehaviour := types.NewMisbehaviour("solomachine-0", 1, sigOne, sigTwo)
+
+	newClientState, err := clientState.CheckMisbehaviourAndUpdateState(sdk.Context{}, cdc, nil, misbehaviour)
+	require.NoError(t, err)
+
+	smClientState, ok := newClientState.(*types.ClientState)
+	require.True(t, ok)
+	require.True(t, smClientState.IsFrozen())
+	require.Equal(t, uint64(1), smClientState.FrozenSequence)
+}
+
+func TestCheckMisbehaviourAndUpdateStateInvalidSignature(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+	otherPrivKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+
+	sigOne := &types.SignatureAndData{
+		Signature: sign(t, cdc, privKey, 1, 10, "diversifier", []byte("path"), []byte("data-one")),
+		Path:      []byte("path"),
+		Data:      []byte("data-one"),
+		Timestamp: 10,
+	}
+	// signed by a key other than the one on file; not valid misbehaviour
+	// against this client
+	sigTwo := &types.SignatureAndData{
+		Signature: sign(t, cdc, otherPrivKey, 1, 10, "diversifier", []byte("path"), []byte("data-two")),
+		Path:      []byte("path"),
+		Data:      []byte("data-two"),
+		Timestamp: 10,
+	}
+	misbehaviour := types.NewMisbehaviour("solomachine-0", 1, sigOne, sigTwo)
+
+	_, err := clientState.CheckMisbehaviourAndUpdateState(sdk.Context{}, cdc, nil, misbehaviour)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrSignatureVerificationFailed))
+}
+
+func TestCheckMisbehaviourAndUpdateStateDifferingPaths(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+
+	sigOne := &types.SignatureAndData{
+		Signature: sign(t, cdc, privKey, 1, 10, "diversifier", []byte("path-one"), []byte("data-one")),
+		Path:      []byte("path-one"),
+		Data:      []byte("data-one"),
+		Timestamp: 10,
+	}
+	// a different, but equally genuine, signature over a different path at
+	// the same sequence is not misbehaviour - the key has not equivocated
+	sigTwo := &types.SignatureAndData{
+		Signature: sign(t, cdc, privKey, 1, 10, "diversifier", []byte("path-two"), []byte("data-two")),
+		Path:      []byte("path-two"),
+		Data:      []byte("data-two"),
+		Timestamp: 10,
+	}
+	misbehaviour := types.NewMisbehaviour("solomachine-0", 1, sigOne, sigTwo)
+
+	_, err := clientState.CheckMisbehaviourAndUpdateState(sdk.Context{}, cdc, nil, misbehaviour)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidMisbehaviour))
+}
+
+func TestCheckMisbehaviourAndUpdateStateSequenceMismatch(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+
+	sigOne := &types.SignatureAndData{
+		Signature: sign(t, cdc, privKey, 2, 10, "diversifier", []byte("path"), []byte("data-one")),
+		Path:      []byte("path"),
+		Data:      []byte("data-one"),
+		Timestamp: 10,
+	}
+	sigTwo := &types.SignatureAndData{
+		Signature: sign(t, cdc, privKey, 2, 10, "diversifier", []byte("path"), []byte("data-two")),
+		Path:      []byte("path"),
+		Data:      []byte("data-two"),
+		Timestamp: 10,
+	}
+	// misbehaviour claims a sequence the client is not currently at
+	misbehaviour := types.NewMisbehaviour("solomachine-0", 2, sigOne, sigTwo)
+
+	_, err := clientState.CheckMisbehaviourAndUpdateState(sdk.Context{}, cdc, nil, misbehaviour)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidMisbehaviour))
+}
+
+func TestCheckMisbehaviourAndUpdateStateAlreadyFrozen(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+	clientState.FrozenSequence = 1
+
+	sigOne := &types.SignatureAndData{
+		Signature: sign(t, cdc, privKey, 1, 10, "diversifier", []byte("path"), []byte("data-one")),
+		Path:      []byte("path"),
+		Data:      []byte("data-one"),
+		Timestamp: 10,
+	}
+	sigTwo := &types.SignatureAndData{
+		Signature: sign(t, cdc, privKey, 1, 10, "diversifier", []byte("path"), []byte("data-two")),
+		Path:      []byte("path"),
+		Data:      []byte("data-two"),
+		Timestamp: 10,
+	}
+	misbehaviour := types.NewMisbehaviour("solomachine-0", 1, sigOne, sigTwo)
+
+	newClientState, err := clientState.CheckMisbehaviourAndUpdateState(sdk.Context{}, cdc, nil, misbehaviour)
+	require.NoError(t, err)
+
+	smClientState, ok := newClientState.(*types.ClientState)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), smClientState.FrozenSequence)
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic ensures a SignatureAndData is well formed.
+func (s SignatureAndData) ValidateBasic() error {
+	if len(s.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignatureAndData, "signature cannot be empty")
+	}
+	if len(s.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignatureAndData, "data for signature cannot be empty")
+	}
+	return nil
+}
+
+// verifySignature unmarshals the public key packed in consensus state,
+// reconstructs the SignBytes for the given sequence/timestamp/path/data, and
+// checks the signature against it.
+func verifySignature(cdc codec.BinaryMarshaler, publicKeyAny *types.Any, sequence, timestamp uint64, diversifier string, path, data, signature []byte) error {
+	var pubKey cryptotypes.PubKey
+	if err := cdc.UnpackAny(publicKeyAny, &pubKey); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPublicKey, err.Error())
+	}
+
+	signBytes := SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		Path:        path,
+		Data:        data,
+	}
+
+	bz := cdc.MustMarshalBinaryBare(&signBytes)
+
+	if !pubKey.VerifySignature(bz, signature) {
+		return ErrSignatureVerificationFailed
+	}
+
+	return nil
+}
+
+// verifyTimestampedSignature unmarshals a proof into a TimestampedSignature
+// and verifies it against the given sequence, path and data.
+func verifyTimestampedSignature(
+	cdc codec.BinaryMarshaler, publicKeyAny *types.Any, sequence uint64, diversifier string, path, data, proof []byte,
+) error {
+	var timestampedSig TimestampedSignature
+	if err := cdc.UnmarshalBinaryBare(proof, &timestampedSig); err != nil {
+		return sdkerrors.Wrap(err, "failed to unmarshal proof into timestamped signature")
+	}
+
+	return verifySignature(cdc, publicKeyAny, sequence, timestampedSig.Timestamp, diversifier, path, data, timestampedSig.Signature)
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new ClientState instance.
+func NewClientState(sequence uint64, consensusState *ConsensusState, allowUpdateAfterProposal bool) *ClientState {
+	return &ClientState{
+		Sequence:                 sequence,
+		ConsensusState:           consensusState,
+		AllowUpdateAfterProposal: allowUpdateAfterProposal,
+	}
+}
+
+// ClientType is solo machine.
+func (cs ClientState) ClientType() exported.ClientType {
+	return exported.SoloMachine
+}
+
+// GetLatestHeight returns the sequence number as the solo machine does not
+// have a notion of height distinct from the number of updates it has seen.
+func (cs ClientState) GetLatestHeight() uint64 {
+	return cs.Sequence
+}
+
+// IsFrozen returns true if the client has been frozen due to misbehaviour.
+func (cs ClientState) IsFrozen() bool {
+	return cs.FrozenSequence != 0
+}
+
+// GetFrozenHeight returns the sequence at which the client was frozen.
+func (cs ClientState) GetFrozenHeight() uint64 {
+	return cs.FrozenSequence
+}
+
+// Validate performs basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if cs.Sequence == 0 {
+		return ErrInvalidSequence
+	}
+	if cs.ConsensusState == nil {
+		return ErrInvalidConsensus
+	}
+	return cs.ConsensusState.ValidateBasic()
+}
+
+// ZeroCustomFields returns a copy of the client state with no
+// solo-machine-specific custom fields, since every field is ledger
+// enforced state used to verify upgrades.
+func (cs ClientState) ZeroCustomFields() exported.ClientState {
+	return &cs
+}
+
+// Initialize checks that the initial consensus state is the consensus state
+// embedded in the client state, as is the case for every other light
+// client's genesis.
+func (cs ClientState) Initialize(_ sdk.Context, _ codec.BinaryMarshaler, _ sdk.KVStore, consState exported.ConsensusState) error {
+	cState, ok := consState.(*ConsensusState)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidConsensus, "invalid consensus state type %T", consState)
+	}
+
+	if !cs.ConsensusState.Equal(cState) {
+		return sdkerrors.Wrap(ErrInvalidConsensus, "initial consensus state does not match client consensus state")
+	}
+
+	return nil
+}
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(publicKey *types.Any, diversifier string, timestamp uint64) *ConsensusState {
+	return &ConsensusState{
+		PublicKey:   publicKey,
+		Diversifier: diversifier,
+		Timestamp:   timestamp,
+	}
+}
+
+// ClientType is solo machine.
+func (cs ConsensusState) ClientType() exported.ClientType {
+	return exported.SoloMachine
+}
+
+// GetRoot returns nil since the solo machine does not have a commitment
+// root, it verifies each proof directly against a signature.
+func (cs ConsensusState) GetRoot() commitmentexported.Root {
+	return nil
+}
+
+// GetTimestamp returns the timestamp (in nanoseconds) of the consensus state.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// ValidateBasic defines basic validation for the solo machine consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.PublicKey == nil {
+		return sdkerrors.Wrap(ErrInvalidPublicKey, "public key cannot be nil")
+	}
+	if strings.TrimSpace(cs.Diversifier) == "" {
+		return sdkerrors.Wrap(ErrInvalidDiversifier, "diversifier cannot be blank")
+	}
+	if cs.Timestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "timestamp cannot be 0")
+	}
+	return nil
+}
+
+// Equal returns true if the two consensus states have identical fields.
+func (cs ConsensusState) Equal(other *ConsensusState) bool {
+	if other == nil {
+		return false
+	}
+	return cs.Diversifier == other.Diversifier &&
+		cs.Timestamp == other.Timestamp &&
+		cs.PublicKey.TypeUrl == other.PublicKey.TypeUrl &&
+		bytes.Equal(cs.PublicKey.Value, other.PublicKey.Value)
+}
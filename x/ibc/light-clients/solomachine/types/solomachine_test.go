@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/light-clients/solomachine/types"
+)
+
+// newTestCodec returns a proto codec with the crypto PubKey interface
+// registered, so that the solo machine's PublicKey Any can be packed and
+// unpacked the same way it would be at the application level.
+func newTestCodec(t *testing.T) *codec.ProtoCodec {
+	t.Helper()
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// newTestClientState builds a fresh, unfrozen solo machine client state
+// authorized by privKey at the given sequence and diversifier.
+func newTestClientState(t *testing.T, privKey cryptotypes.PrivKey, sequence uint64, diversifier string, timestamp uint64) *types.ClientState {
+	t.Helper()
+
+	pubKeyAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
+
+	consensusState := types.NewConsensusState(pubKeyAny, diversifier, timestamp)
+	return types.NewClientState(sequence, consensusState, false)
+}
+
+// sign produces a raw signature, by privKey, over the SignBytes for the
+// given sequence/timestamp/diversifier/path/data, matching the bytes every
+// solo machine proof is checked against.
+func sign(t *testing.T, cdc codec.BinaryMarshaler, privKey cryptotypes.PrivKey, sequence, timestamp uint64, diversifier string, path, data []byte) []byte {
+	t.Helper()
+
+	signBytes := types.SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		Path:        path,
+		Data:        data,
+	}
+
+	sig, err := privKey.Sign(cdc.MustMarshalBinaryBare(&signBytes))
+	require.NoError(t, err)
+
+	return sig
+}
+
+// signTimestamped wraps sign's output in a marshaled TimestampedSignature,
+// the proof format expected by VerifyClientState/VerifyClientConsensusState
+// and VerifyUpgradeAndUpdateState.
+func signTimestamped(t *testing.T, cdc codec.BinaryMarshaler, privKey cryptotypes.PrivKey, sequence, timestamp uint64, diversifier string, path, data []byte) []byte {
+	t.Helper()
+
+	timestampedSig := types.TimestampedSignature{
+		Signature: sign(t, cdc, privKey, sequence, timestamp, diversifier, path, data),
+		Timestamp: timestamp,
+	}
+
+	return cdc.MustMarshalBinaryBare(&timestampedSig)
+}
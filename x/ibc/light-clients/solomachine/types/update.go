@@ -0,0 +1,97 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+// CheckHeaderAndUpdateState checks that the header's sequence matches the
+// client's current sequence and that it is signed by the client's current
+// public key over the new consensus state (new public key, diversifier and
+// timestamp), then rotates the client to the new key and bumps its sequence.
+func (cs ClientState) CheckHeaderAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryMarshaler, clientStore sdk.KVStore, header exported.Header,
+) (exported.ClientState, exported.ConsensusState, error) {
+	smHeader, ok := header.(*Header)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidHeader, "header type %T is not solo machine header", header)
+	}
+
+	if cs.IsFrozen() {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidHeader, "client is frozen at sequence %d", cs.FrozenSequence)
+	}
+
+	if smHeader.Sequence != cs.Sequence {
+		return nil, nil, sdkerrors.Wrapf(
+			ErrInvalidHeader, "header sequence %d does not match client sequence %d", smHeader.Sequence, cs.Sequence,
+		)
+	}
+
+	if smHeader.Timestamp < cs.ConsensusState.Timestamp {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidHeader, "header timestamp is less than the consensus state timestamp")
+	}
+
+	newDiversifier := smHeader.NewDiversifier
+	if newDiversifier == "" {
+		newDiversifier = cs.ConsensusState.Diversifier
+	}
+
+	newConsState := NewConsensusState(smHeader.NewPublicKey, newDiversifier, smHeader.Timestamp)
+
+	// sign over the full new consensus state, not just the new public key, so
+	// that the new diversifier is bound to the signature as well
+	newConsStateBz := cdc.MustMarshalBinaryBare(newConsState)
+	if err := verifySignature(
+		cdc, cs.ConsensusState.PublicKey, smHeader.Sequence, smHeader.Timestamp, cs.ConsensusState.Diversifier,
+		[]byte("updateClient"), newConsStateBz, smHeader.Signature,
+	); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to verify header signature")
+	}
+
+	newClientState := NewClientState(cs.Sequence+1, newConsState, cs.AllowUpdateAfterProposal)
+
+	return newClientState, newConsState, nil
+}
+
+// VerifyUpgradeAndUpdateState verifies that the new client and consensus
+// state were signed, at the client's current sequence, by the solo machine's
+// current public key, and returns them unmodified other than setting the new
+// client's sequence to the current sequence plus one.
+// Unlike a Tendermint client, a solo machine has no counterparty chain
+// state to reconcile against; "proof" here simply means the holder of the
+// key authorized this particular upgrade.
+func (cs ClientState) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryMarshaler, store sdk.KVStore,
+	newClient exported.ClientState, newConsState exported.ConsensusState,
+	proofUpgradeClient, proofUpgradeConsState []byte,
+) (exported.ClientState, exported.ConsensusState, error) {
+	if cs.IsFrozen() {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidHeader, "client is frozen at sequence %d", cs.FrozenSequence)
+	}
+
+	clientStateBz := cdc.MustMarshalBinaryBare(newClient)
+	if err := verifyTimestampedSignature(
+		cdc, cs.ConsensusState.PublicKey, cs.Sequence, cs.ConsensusState.Diversifier,
+		[]byte("upgradeClient"), clientStateBz, proofUpgradeClient,
+	); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to verify client state upgrade proof")
+	}
+
+	consStateBz := cdc.MustMarshalBinaryBare(newConsState)
+	if err := verifyTimestampedSignature(
+		cdc, cs.ConsensusState.PublicKey, cs.Sequence, cs.ConsensusState.Diversifier,
+		[]byte("upgradeConsensusState"), consStateBz, proofUpgradeConsState,
+	); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to verify consensus state upgrade proof")
+	}
+
+	newCS, ok := newClient.(*ClientState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidHeader, "client state type %T is not solo machine client state", newClient)
+	}
+	newCS.Sequence = cs.Sequence + 1
+
+	return newCS, newConsState, nil
+}
@@ -0,0 +1,136 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/light-clients/solomachine/types"
+)
+
+func TestCheckHeaderAndUpdateState(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+	newPrivKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+
+	newPubKeyAny, err := codectypes.NewAnyWithValue(newPrivKey.PubKey())
+	require.NoError(t, err)
+
+	newConsStateBz := cdc.MustMarshalBinaryBare(types.NewConsensusState(newPubKeyAny, "new-diversifier", 11))
+
+	header := types.NewHeader(
+		1, 11,
+		sign(t, cdc, privKey, 1, 11, "diversifier", []byte("updateClient"), newConsStateBz),
+		newPubKeyAny, "new-diversifier",
+	)
+
+	newClientState, newConsState, err := clientState.CheckHeaderAndUpdateState(sdk.Context{}, cdc, nil, header)
+	require.NoError(t, err)
+
+	smClientState, ok := newClientState.(*types.ClientState)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), smClientState.Sequence)
+
+	smConsState, ok := newConsState.(*types.ConsensusState)
+	require.True(t, ok)
+	require.Equal(t, "new-diversifier", smConsState.Diversifier)
+	require.Equal(t, uint64(11), smConsState.Timestamp)
+}
+
+func TestCheckHeaderAndUpdateStateInvalidSignature(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+	newPrivKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+
+	newPubKeyAny, err := codectypes.NewAnyWithValue(newPrivKey.PubKey())
+	require.NoError(t, err)
+
+	newConsStateBz := cdc.MustMarshalBinaryBare(types.NewConsensusState(newPubKeyAny, "new-diversifier", 11))
+
+	header := types.NewHeader(
+		1, 11,
+		// signed by the wrong key
+		sign(t, cdc, newPrivKey, 1, 11, "diversifier", []byte("updateClient"), newConsStateBz),
+		newPubKeyAny, "new-diversifier",
+	)
+
+	_, _, err = clientState.CheckHeaderAndUpdateState(sdk.Context{}, cdc, nil, header)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrSignatureVerificationFailed))
+}
+
+func TestCheckHeaderAndUpdateStateTamperedDiversifier(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+	newPrivKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+
+	newPubKeyAny, err := codectypes.NewAnyWithValue(newPrivKey.PubKey())
+	require.NoError(t, err)
+
+	newConsStateBz := cdc.MustMarshalBinaryBare(types.NewConsensusState(newPubKeyAny, "new-diversifier", 11))
+
+	header := types.NewHeader(
+		1, 11,
+		// signature is over "new-diversifier", but the header swaps in a
+		// different diversifier post-signing
+		sign(t, cdc, privKey, 1, 11, "diversifier", []byte("updateClient"), newConsStateBz),
+		newPubKeyAny, "tampered-diversifier",
+	)
+
+	_, _, err = clientState.CheckHeaderAndUpdateState(sdk.Context{}, cdc, nil, header)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrSignatureVerificationFailed))
+}
+
+func TestCheckHeaderAndUpdateStateWrongSequence(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+	newPrivKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 2, "diversifier", 10)
+
+	newPubKeyAny, err := codectypes.NewAnyWithValue(newPrivKey.PubKey())
+	require.NoError(t, err)
+
+	header := types.NewHeader(
+		1, 11,
+		sign(t, cdc, privKey, 1, 11, "diversifier", []byte("updateClient"), newPubKeyAny.Value),
+		newPubKeyAny, "new-diversifier",
+	)
+
+	_, _, err = clientState.CheckHeaderAndUpdateState(sdk.Context{}, cdc, nil, header)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidHeader))
+}
+
+func TestCheckHeaderAndUpdateStateFrozenClient(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+	newPrivKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+	clientState.FrozenSequence = 1
+
+	newPubKeyAny, err := codectypes.NewAnyWithValue(newPrivKey.PubKey())
+	require.NoError(t, err)
+
+	header := types.NewHeader(
+		1, 11,
+		sign(t, cdc, privKey, 1, 11, "diversifier", []byte("updateClient"), newPubKeyAny.Value),
+		newPubKeyAny, "new-diversifier",
+	)
+
+	_, _, err = clientState.CheckHeaderAndUpdateState(sdk.Context{}, cdc, nil, header)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidHeader))
+}
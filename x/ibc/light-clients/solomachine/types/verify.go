@@ -0,0 +1,50 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// VerifyClientState verifies a proof of the client state of the
+// counterparty stored on the solo machine, i.e. that the current holder of
+// the private key attested to that particular client state at this
+// sequence.
+func (cs ClientState) VerifyClientState(
+	store sdk.KVStore, cdc codec.BinaryMarshaler, sequence uint64,
+	prefix commitmentexported.Prefix, counterpartyClientIdentifier string, proof []byte, clientState exported.ClientState,
+) error {
+	path := host.FullClientStatePath(counterpartyClientIdentifier)
+	return cs.verifyMembership(cdc, sequence, prefix, proof, []byte(path), cdc.MustMarshalBinaryBare(clientState))
+}
+
+// VerifyClientConsensusState verifies a proof of the consensus state of the
+// counterparty at consensusHeight, stored on the solo machine.
+func (cs ClientState) VerifyClientConsensusState(
+	store sdk.KVStore, cdc codec.BinaryMarshaler, sequence uint64, counterpartyClientIdentifier string,
+	consensusHeight uint64, prefix commitmentexported.Prefix, proof []byte, consensusState exported.ConsensusState,
+) error {
+	path := host.FullConsensusStatePath(counterpartyClientIdentifier, consensusHeight)
+	return cs.verifyMembership(cdc, sequence, prefix, proof, []byte(path), cdc.MustMarshalBinaryBare(consensusState))
+}
+
+// verifyMembership checks that the solo machine's current public key signed
+// over the given path and data at the claimed sequence.
+func (cs ClientState) verifyMembership(
+	cdc codec.BinaryMarshaler, sequence uint64, prefix commitmentexported.Prefix, proof, path, data []byte,
+) error {
+	if cs.IsFrozen() {
+		return sdkerrors.Wrapf(ErrInvalidHeader, "client is frozen at sequence %d", cs.FrozenSequence)
+	}
+
+	if sequence != cs.Sequence {
+		return sdkerrors.Wrapf(ErrInvalidSequence, "sequence %d does not match client sequence %d", sequence, cs.Sequence)
+	}
+
+	fullPath := append([]byte(prefix.String()), path...)
+
+	return verifyTimestampedSignature(cdc, cs.ConsensusState.PublicKey, sequence, cs.ConsensusState.Diversifier, fullPath, data, proof)
+}
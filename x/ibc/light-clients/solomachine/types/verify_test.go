@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	commitment "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/light-clients/solomachine/types"
+)
+
+func TestVerifyClientState(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+	counterpartyClientState := newTestClientState(t, secp256k1.GenPrivKey(), 1, "counterparty-diversifier", 10)
+
+	prefix := commitment.NewPrefix([]byte("ibc"))
+	path := []byte(prefix.String() + host.FullClientStatePath("counterpartyclient"))
+	data := cdc.MustMarshalBinaryBare(counterpartyClientState)
+
+	proof := signTimestamped(t, cdc, privKey, 1, 10, "diversifier", path, data)
+
+	err := clientState.VerifyClientState(nil, cdc, 1, prefix, "counterpartyclient", proof, counterpartyClientState)
+	require.NoError(t, err)
+}
+
+func TestVerifyClientStateTamperedProof(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+	counterpartyClientState := newTestClientState(t, secp256k1.GenPrivKey(), 1, "counterparty-diversifier", 10)
+	tamperedClientState := newTestClientState(t, secp256k1.GenPrivKey(), 2, "counterparty-diversifier", 10)
+
+	prefix := commitment.NewPrefix([]byte("ibc"))
+	path := []byte(prefix.String() + host.FullClientStatePath("counterpartyclient"))
+	data := cdc.MustMarshalBinaryBare(counterpartyClientState)
+
+	proof := signTimestamped(t, cdc, privKey, 1, 10, "diversifier", path, data)
+
+	// proof was produced over counterpartyClientState, not tamperedClientState
+	err := clientState.VerifyClientState(nil, cdc, 1, prefix, "counterpartyclient", proof, tamperedClientState)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrSignatureVerificationFailed))
+}
+
+func TestVerifyClientStateFrozen(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+	clientState.FrozenSequence = 1
+	counterpartyClientState := newTestClientState(t, secp256k1.GenPrivKey(), 1, "counterparty-diversifier", 10)
+
+	prefix := commitment.NewPrefix([]byte("ibc"))
+	path := []byte(prefix.String() + host.FullClientStatePath("counterpartyclient"))
+	data := cdc.MustMarshalBinaryBare(counterpartyClientState)
+
+	proof := signTimestamped(t, cdc, privKey, 1, 10, "diversifier", path, data)
+
+	err := clientState.VerifyClientState(nil, cdc, 1, prefix, "counterpartyclient", proof, counterpartyClientState)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidHeader))
+}
+
+func TestVerifyClientConsensusState(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+	counterpartyConsState := newTestClientState(t, secp256k1.GenPrivKey(), 1, "counterparty-diversifier", 10).ConsensusState
+
+	prefix := commitment.NewPrefix([]byte("ibc"))
+	path := []byte(prefix.String() + host.FullConsensusStatePath("counterpartyclient", 5))
+	data := cdc.MustMarshalBinaryBare(counterpartyConsState)
+
+	proof := signTimestamped(t, cdc, privKey, 1, 10, "diversifier", path, data)
+
+	err := clientState.VerifyClientConsensusState(nil, cdc, 1, "counterpartyclient", 5, prefix, proof, counterpartyConsState)
+	require.NoError(t, err)
+}
+
+func TestVerifyClientConsensusStateTamperedProof(t *testing.T) {
+	cdc := newTestCodec(t)
+	privKey := secp256k1.GenPrivKey()
+
+	clientState := newTestClientState(t, privKey, 1, "diversifier", 10)
+	counterpartyConsState := newTestClientState(t, secp256k1.GenPrivKey(), 1, "counterparty-diversifier", 10).ConsensusState
+	tamperedConsState := newTestClientState(t, secp256k1.GenPrivKey(), 1, "tampered-diversifier", 10).ConsensusState
+
+	prefix := commitment.NewPrefix([]byte("ibc"))
+	path := []byte(prefix.String() + host.FullConsensusStatePath("counterpartyclient", 5))
+	data := cdc.MustMarshalBinaryBare(counterpartyConsState)
+
+	proof := signTimestamped(t, cdc, privKey, 1, 10, "diversifier", path, data)
+
+	err := clientState.VerifyClientConsensusState(nil, cdc, 1, "counterpartyclient", 5, prefix, proof, tamperedConsState)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrSignatureVerificationFailed))
+}